@@ -0,0 +1,7 @@
+data "bridge" "example" {
+  bridge_id = "demo"
+}
+
+output "bridge_remote_url" {
+  value = data.bridge.example.remote.url
+}
@@ -0,0 +1,8 @@
+data "bridges" "saas" {
+  id_prefix  = "prod-"
+  remote_url = "https://your_SaaS_JPD.org"
+}
+
+output "bridge_ids" {
+  value = [for b in data.bridges.saas.bridges : b.bridge_id]
+}
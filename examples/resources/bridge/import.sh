@@ -0,0 +1 @@
+terraform import bridge.demo my-bridge-id
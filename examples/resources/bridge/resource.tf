@@ -3,6 +3,9 @@ resource "bridge_bridge" "example" {
   bridge_id     = "demo"
   pairing_token = "<pairing token from bridge server>"
 
+  # pairing_token is write-only and never stored in state, bump the version with a new token to re-pair the bridge
+  pairing_token_wo_version = 1
+
   remote {
     url      = "https://your_SaaS_JPD.org"
     insecure = false
@@ -39,4 +42,7 @@ resource "bridge_bridge" "example" {
       allow_close_used_tunnels = true
     }
   }
+
+  # Set to false in a separate apply before destroying or replacing the bridge
+  deletion_protection = true
 }
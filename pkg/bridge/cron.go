@@ -0,0 +1,97 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/robfig/cron/v3"
+)
+
+// cronNextRunsCount is the number of scheduled runs listed in jobs.tunnel_closing.next_runs
+const cronNextRunsCount = 5
+
+// cronParser parses the same dialect as the bridge client: standard 5 fields cron expressions
+// (minute hour day-of-month month day-of-week), descriptors such as @daily or @every 1h and an optional CRON_TZ= prefix.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Ensure our implementation satisfies the validator.String interface.
+var _ validator.String = &cronExprValidator{}
+
+type cronExprValidator struct{}
+
+func (v cronExprValidator) Description(_ context.Context) string {
+	return "value must be a valid cron expression (minute hour day-of-month month day-of-week) or descriptor (e.g. @daily, @every 1h)"
+}
+
+func (v cronExprValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExprValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if _, err := cronParser.Parse(value); err != nil {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			fmt.Sprintf("%q: %s", value, err),
+		))
+	}
+}
+
+// isCronExpr returns a validator which ensures the value is parsed by the bridge client cron dialect.
+func isCronExpr() validator.String {
+	return cronExprValidator{}
+}
+
+// cronNextRuns returns the next scheduled runs of the cron expression after from, formatted as RFC3339 timestamps.
+// An unknown or invalid expression results in an unknown or null list respectively.
+func cronNextRuns(ctx context.Context, cronExpr types.String, from time.Time) (types.List, diag.Diagnostics) {
+	if cronExpr.IsUnknown() {
+		return types.ListUnknown(types.StringType), nil
+	}
+	if cronExpr.IsNull() {
+		return types.ListNull(types.StringType), nil
+	}
+
+	schedule, err := cronParser.Parse(cronExpr.ValueString())
+	if err != nil {
+		// reported by the cron_expr validator
+		return types.ListNull(types.StringType), nil
+	}
+
+	runs := make([]string, 0, cronNextRunsCount)
+	next := from
+	for range cronNextRunsCount {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			break
+		}
+		runs = append(runs, next.Format(time.RFC3339))
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, runs)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronNextRuns(t *testing.T) {
+	from := time.Date(2025, time.January, 1, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		cronExpr types.String
+		want     types.List
+	}{
+		{
+			name:     "null",
+			cronExpr: types.StringNull(),
+			want:     types.ListNull(types.StringType),
+		},
+		{
+			name:     "unknown",
+			cronExpr: types.StringUnknown(),
+			want:     types.ListUnknown(types.StringType),
+		},
+		{
+			name:     "invalid",
+			cronExpr: types.StringValue("not a cron"),
+			want:     types.ListNull(types.StringType),
+		},
+		{
+			name:     "daily at midnight",
+			cronExpr: types.StringValue("0 0 * * *"),
+			want: testStringList(t,
+				"2025-01-02T00:00:00Z",
+				"2025-01-03T00:00:00Z",
+				"2025-01-04T00:00:00Z",
+				"2025-01-05T00:00:00Z",
+				"2025-01-06T00:00:00Z",
+			),
+		},
+		{
+			name:     "every 15 minutes",
+			cronExpr: types.StringValue("*/15 * * * *"),
+			want: testStringList(t,
+				"2025-01-01T10:45:00Z",
+				"2025-01-01T11:00:00Z",
+				"2025-01-01T11:15:00Z",
+				"2025-01-01T11:30:00Z",
+				"2025-01-01T11:45:00Z",
+			),
+		},
+		{
+			name:     "descriptor",
+			cronExpr: types.StringValue("@monthly"),
+			want: testStringList(t,
+				"2025-02-01T00:00:00Z",
+				"2025-03-01T00:00:00Z",
+				"2025-04-01T00:00:00Z",
+				"2025-05-01T00:00:00Z",
+				"2025-06-01T00:00:00Z",
+			),
+		},
+		{
+			name:     "interval",
+			cronExpr: types.StringValue("@every 1h"),
+			want: testStringList(t,
+				"2025-01-01T11:30:00Z",
+				"2025-01-01T12:30:00Z",
+				"2025-01-01T13:30:00Z",
+				"2025-01-01T14:30:00Z",
+				"2025-01-01T15:30:00Z",
+			),
+		},
+		{
+			name:     "never matches",
+			cronExpr: types.StringValue("0 0 30 2 *"),
+			want:     testStringList(t),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := cronNextRuns(context.Background(), tc.cronExpr, from)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func testStringList(t *testing.T, values ...string) types.List {
+	t.Helper()
+
+	list, diags := types.ListValueFrom(context.Background(), types.StringType, append([]string{}, values...))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return list
+}
@@ -0,0 +1,291 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+var _ datasource.DataSource = &BridgeDataSource{}
+
+func NewBridgeDataSource() datasource.DataSource {
+	return &BridgeDataSource{}
+}
+
+type BridgeDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type bridgeRemoteDataSourceModel struct {
+	Url      types.String      `tfsdk:"url"`
+	Insecure types.Bool        `tfsdk:"insecure"`
+	Proxy    *bridgeProxyModel `tfsdk:"proxy"`
+}
+
+// BridgeDataSourceModel has the same shape as BridgeResourceModel, without the pairing and remote tokens.
+type BridgeDataSourceModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	BridgeID    types.String                 `tfsdk:"bridge_id"`
+	Remote      *bridgeRemoteDataSourceModel `tfsdk:"remote"`
+	Local       *bridgeLocalModel            `tfsdk:"local"`
+	MinTunnels  types.Int64                  `tfsdk:"min_tunnels"`
+	MaxTunnels  types.Int64                  `tfsdk:"max_tunnels"`
+	TargetUsage *bridgeTargetUsageModel      `tfsdk:"target_usage"`
+	Jobs        *bridgeJobsModel             `tfsdk:"jobs"`
+	CreatedAt   types.String                 `tfsdk:"created_at"`
+}
+
+func (d *BridgeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName // data source name is just "bridge"
+	d.TypeName = resp.TypeName
+}
+
+func (d *BridgeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := bridgeDataSourceAttributes()
+	attributes["bridge_id"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "Unique identifier of the bridge.",
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read a single JFrog Bridge via the bridge-client API.",
+		Attributes:          attributes,
+	}
+}
+
+// bridgeDataSourceAttributes returns the computed attributes of a single bridge, shared by the bridge and bridges data
+// sources.
+func bridgeDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Same as bridge_id.",
+		},
+		"bridge_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Unique identifier of the bridge.",
+		},
+		"remote": bridgeRemoteDataSourceSchema(),
+		"local":  bridgeLocalDataSourceSchema(),
+		"min_tunnels": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Minimum tunnels.",
+		},
+		"max_tunnels": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Maximum tunnels.",
+		},
+		"target_usage": bridgeTargetUsageDataSourceSchema(),
+		"jobs":         bridgeJobsDataSourceSchema(),
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Timestamp when the bridge was created (from debug snapshot).",
+		},
+	}
+}
+
+func bridgeRemoteDataSourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Remote (bridge server) configuration.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the bridge server (remote JPD).",
+			},
+			"insecure": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Allow insecure TLS when connecting to the remote.",
+			},
+			"proxy": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Proxy configuration used by the remote connection.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether proxy is enabled.",
+					},
+					"cache_expiration_secs": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Proxy cache expiration in seconds.",
+					},
+					"key": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Proxy key.",
+					},
+					"scheme_override": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Override proxy scheme (e.g., http/https).",
+					},
+				},
+			},
+		},
+	}
+}
+
+func bridgeLocalDataSourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Local (bridge client) configuration.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "URL of the bridge client (local JPD).",
+			},
+			"anonymous_endpoints": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of anonymous endpoints allowed through the bridge.",
+			},
+			"dial_timeout_secs": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timeout in seconds when dialing the local JPD.",
+			},
+		},
+	}
+}
+
+func bridgeTargetUsageDataSourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Target usage thresholds.",
+		Attributes: map[string]schema.Attribute{
+			"low": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Low usage threshold.",
+			},
+			"high": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "High usage threshold.",
+			},
+		},
+	}
+}
+
+func bridgeJobsDataSourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Job configuration for tunnel creation/closing.",
+		Attributes: map[string]schema.Attribute{
+			"tunnel_creation": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"interval_minutes": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Interval in minutes for tunnel creation.",
+					},
+				},
+			},
+			"tunnel_closing": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"cron_expr": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Cron expression for tunnel closing.",
+					},
+					"allow_close_used_tunnels": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether to allow closing used tunnels.",
+					},
+					"next_runs": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Next scheduled tunnel closing times (RFC3339) for `cron_expr`.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BridgeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *BridgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BridgeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bridge, err := getBridge(ctx, d.ProviderData.Client, data.BridgeID.ValueString())
+	if err != nil {
+		if !addRequestError(ctx, &resp.Diagnostics, err) {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				fmt.Sprintf("An unexpected error occurred while reading bridge %s.\n\nError: %s", data.BridgeID.ValueString(), err),
+			)
+		}
+		return
+	}
+	if bridge == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bridge_id"),
+			"Bridge not found",
+			fmt.Sprintf("Bridge %s does not exist on the bridge client.", data.BridgeID.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.fromAPIModel(ctx, *bridge)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fromAPIModel uses the same mapping as the bridge resource import, populating every attribute returned by the server.
+// Unlike the resource, next_runs is calculated on every read.
+func (m *BridgeDataSourceModel) fromAPIModel(ctx context.Context, bridge bridgeDebugEntry) diag.Diagnostics {
+	var resourceModel BridgeResourceModel
+	diags := resourceModel.fromAPIModel(ctx, bridge, true)
+
+	m.ID = resourceModel.ID
+	m.BridgeID = resourceModel.BridgeID
+	m.Remote = &bridgeRemoteDataSourceModel{
+		Url:      resourceModel.Remote.Url,
+		Insecure: resourceModel.Remote.Insecure,
+		Proxy:    resourceModel.Remote.Proxy,
+	}
+	m.Local = resourceModel.Local
+	m.MinTunnels = resourceModel.MinTunnels
+	m.MaxTunnels = resourceModel.MaxTunnels
+	m.TargetUsage = resourceModel.TargetUsage
+	m.Jobs = resourceModel.Jobs
+	m.CreatedAt = resourceModel.CreatedAt
+
+	if m.Jobs != nil && m.Jobs.TunnelClosing != nil {
+		nextRuns, d := cronNextRuns(ctx, m.Jobs.TunnelClosing.CronExpr, time.Now().UTC())
+		diags.Append(d...)
+		m.Jobs.TunnelClosing.NextRuns = nextRuns
+	}
+
+	return diags
+}
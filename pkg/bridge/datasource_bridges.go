@@ -0,0 +1,128 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validator_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+)
+
+var _ datasource.DataSource = &BridgesDataSource{}
+
+func NewBridgesDataSource() datasource.DataSource {
+	return &BridgesDataSource{}
+}
+
+type BridgesDataSource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type BridgesDataSourceModel struct {
+	IDPrefix  types.String            `tfsdk:"id_prefix"`
+	RemoteUrl types.String            `tfsdk:"remote_url"`
+	Bridges   []BridgeDataSourceModel `tfsdk:"bridges"`
+}
+
+func (d *BridgesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "s" // data source name is "bridges"
+	d.TypeName = resp.TypeName
+}
+
+func (d *BridgesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List every JFrog Bridge defined on the bridge client, optionally filtered by ID prefix or remote URL.",
+		Attributes: map[string]schema.Attribute{
+			"id_prefix": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Only list bridges whose `bridge_id` starts with this prefix.",
+			},
+			"remote_url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validator_string.IsURLHttpOrHttps(),
+				},
+				MarkdownDescription: "Only list bridges connected to this bridge server (remote JPD) URL. A trailing slash is ignored.",
+			},
+			"bridges": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bridgeDataSourceAttributes(),
+				},
+				MarkdownDescription: "Bridges matching the filters, sorted as returned by the bridge client.",
+			},
+		},
+	}
+}
+
+func (d *BridgesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *BridgesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BridgesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bridges, err := listBridges(ctx, d.ProviderData.Client)
+	if err != nil {
+		if !addRequestError(ctx, &resp.Diagnostics, err) {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				fmt.Sprintf("An unexpected error occurred while listing bridges.\n\nError: %s", err),
+			)
+		}
+		return
+	}
+
+	idPrefix := data.IDPrefix.ValueString()
+	remoteUrl := strings.TrimSuffix(data.RemoteUrl.ValueString(), "/")
+
+	data.Bridges = []BridgeDataSourceModel{}
+	for _, bridge := range bridges {
+		if !strings.HasPrefix(bridge.ID, idPrefix) {
+			continue
+		}
+		if remoteUrl != "" && (bridge.Config.Remote == nil || !sameURL(bridge.Config.Remote.Url, remoteUrl)) {
+			continue
+		}
+
+		var model BridgeDataSourceModel
+		resp.Diagnostics.Append(model.fromAPIModel(ctx, bridge)...)
+		data.Bridges = append(data.Bridges, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
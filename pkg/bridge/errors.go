@@ -0,0 +1,222 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// bridgeAPIFieldAliases maps field names reported by the bridge-client API to attribute paths, where they differ. The
+// create request sends remote and local as plain URLs.
+var bridgeAPIFieldAliases = map[string]string{
+	"id":     "bridge_id",
+	"remote": "remote.url",
+	"local":  "local.url",
+}
+
+var bridgeAPIFieldIndexRegex = regexp.MustCompile(`^(\w+)\[(\d+)]$`)
+
+// bridgeAPIError is the error envelope returned by the bridge-client API:
+//
+//	{"status": 400, "message": "invalid bridge config", "errors": [{"field": "remote.url", "message": "must be https"}]}
+//
+// JFrog style envelopes, with only a list of errors each holding a status and a message, are decoded as well.
+type bridgeAPIError struct {
+	StatusCode int                    `json:"-"`
+	Status     int                    `json:"status"`
+	Message    string                 `json:"message"`
+	Errors     []bridgeAPIErrorDetail `json:"errors"`
+}
+
+type bridgeAPIErrorDetail struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// newBridgeAPIError decodes the error envelope of a failed response. A body which is not an error envelope is kept as
+// the message.
+func newBridgeAPIError(response *resty.Response) *bridgeAPIError {
+	apiErr := &bridgeAPIError{}
+	if err := json.Unmarshal(response.Body(), apiErr); err != nil || (apiErr.Message == "" && len(apiErr.Errors) == 0) {
+		apiErr = &bridgeAPIError{Message: strings.TrimSpace(response.String())}
+	}
+	apiErr.StatusCode = response.StatusCode()
+
+	return apiErr
+}
+
+func (e *bridgeAPIError) Error() string {
+	messages := []string{}
+	if e.Message != "" {
+		messages = append(messages, e.Message)
+	}
+	for _, detail := range e.Errors {
+		if detail.Field != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", detail.Field, detail.Message))
+		} else if detail.Message != "" {
+			messages = append(messages, detail.Message)
+		}
+	}
+
+	status := fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if len(messages) == 0 {
+		return status
+	}
+
+	return fmt.Sprintf("%s: %s", status, strings.Join(messages, "; "))
+}
+
+// summary returns the diagnostic summary and guidance for the failure classes users can act on, or empty strings for
+// unexpected failures.
+func (e *bridgeAPIError) summary() (string, string) {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return "Bridge client authentication failed",
+			"The access token was rejected. Check access_token (or JFROG_ACCESS_TOKEN) and the OIDC configuration of the provider."
+	case http.StatusForbidden:
+		return "Bridge client permission denied",
+			"The access token is not allowed to manage bridges. The bridge-client API requires a token with Admin privileges."
+	case http.StatusConflict:
+		return "Bridge conflict",
+			"The bridge conflicts with the current state of the bridge client, e.g. a bridge with the same bridge_id already exists. " +
+				"Import it, or set adopt_existing to take it into state."
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return "Invalid bridge configuration", "The bridge client rejected the bridge configuration."
+	}
+
+	return "", ""
+}
+
+// addBridgeAPIError reports an error returned by the bridge-client API. Field errors are reported on the matching
+// attribute. Returns false for unexpected failures (e.g. 5xx) without field errors, which are left for the caller to
+// report.
+func addBridgeAPIError(diags *diag.Diagnostics, apiErr *bridgeAPIError) bool {
+	summary, guidance := apiErr.summary()
+
+	hasFieldErrors := false
+	for _, detail := range apiErr.Errors {
+		if detail.Field != "" {
+			hasFieldErrors = true
+			break
+		}
+	}
+	if summary == "" && !hasFieldErrors {
+		return false
+	}
+	if summary == "" {
+		summary = "Bridge client API error"
+	}
+
+	messages := []string{}
+	if apiErr.Message != "" {
+		messages = append(messages, apiErr.Message)
+	}
+	for _, fieldErr := range apiErr.Errors {
+		if fieldErr.Field != "" {
+			diags.AddAttributeError(bridgeAPIFieldPath(fieldErr.Field), summary, fieldErr.Message)
+		} else if fieldErr.Message != "" && fieldErr.Message != apiErr.Message {
+			messages = append(messages, fieldErr.Message)
+		}
+	}
+
+	detail := strings.Join(messages, "\n")
+	if detail == "" {
+		detail = fmt.Sprintf("%d %s", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+	}
+	if guidance != "" {
+		detail = fmt.Sprintf("%s\n\n%s", detail, guidance)
+	}
+	diags.AddError(summary, detail)
+
+	return true
+}
+
+// bridgeAPIFieldPath converts a field reported by the API, such as jobs.tunnel_closing.cron_expr or
+// local.anonymous_endpoints[0], to the attribute path.
+func bridgeAPIFieldPath(field string) path.Path {
+	if alias, ok := bridgeAPIFieldAliases[field]; ok {
+		field = alias
+	}
+
+	var attrPath path.Path
+	for i, step := range strings.Split(field, ".") {
+		name, index := step, -1
+		if match := bridgeAPIFieldIndexRegex.FindStringSubmatch(step); match != nil {
+			name = match[1]
+			index, _ = strconv.Atoi(match[2])
+		}
+
+		if i == 0 {
+			attrPath = path.Root(name)
+		} else {
+			attrPath = attrPath.AtName(name)
+		}
+		if index >= 0 {
+			attrPath = attrPath.AtListIndex(index)
+		}
+	}
+
+	return attrPath
+}
+
+// addRequestError reports a request error the user can act on: an aborted request, or an error returned by the
+// bridge-client API with a known cause or field errors. Returns false for any other error, which is left for the caller
+// to report.
+func addRequestError(ctx context.Context, diags *diag.Diagnostics, err error) bool {
+	if addRequestAbortedError(ctx, diags, err) {
+		return true
+	}
+
+	var apiErr *bridgeAPIError
+	if errors.As(err, &apiErr) {
+		return addBridgeAPIError(diags, apiErr)
+	}
+
+	return false
+}
+
+// addRequestAbortedError reports a request error caused by Terraform cancelling the operation (e.g. Ctrl-C) or by the
+// operation deadline. Returns false for any other error, which is left for the caller to report.
+func addRequestAbortedError(ctx context.Context, diags *diag.Diagnostics, err error) bool {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(ctx.Err(), context.Canceled):
+		diags.AddError(
+			"Operation cancelled",
+			"The request to the bridge-client API was cancelled before it completed. "+
+				"The bridge may have been partially changed, run terraform plan to review its current state.",
+		)
+		return true
+	case errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded):
+		diags.AddError(
+			"Operation timed out",
+			"The request to the bridge-client API did not complete before the operation deadline. "+
+				"Increase the corresponding value in the timeouts block if the bridge client is slow to respond.",
+		)
+		return true
+	}
+
+	return false
+}
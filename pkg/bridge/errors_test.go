@@ -0,0 +1,70 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestBridgeAPIFieldPath(t *testing.T) {
+	testCases := []struct {
+		field string
+		want  path.Path
+	}{
+		{
+			field: "min_tunnels",
+			want:  path.Root("min_tunnels"),
+		},
+		{
+			field: "remote.url",
+			want:  path.Root("remote").AtName("url"),
+		},
+		{
+			field: "jobs.tunnel_closing.cron_expr",
+			want:  path.Root("jobs").AtName("tunnel_closing").AtName("cron_expr"),
+		},
+		{
+			field: "local.anonymous_endpoints[0]",
+			want:  path.Root("local").AtName("anonymous_endpoints").AtListIndex(0),
+		},
+		{
+			field: "local.anonymous_endpoints[12]",
+			want:  path.Root("local").AtName("anonymous_endpoints").AtListIndex(12),
+		},
+		{
+			field: "id",
+			want:  path.Root("bridge_id"),
+		},
+		{
+			field: "remote",
+			want:  path.Root("remote").AtName("url"),
+		},
+		{
+			field: "local",
+			want:  path.Root("local").AtName("url"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.field, func(t *testing.T) {
+			got := bridgeAPIFieldPath(tc.field)
+			if !got.Equal(tc.want) {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
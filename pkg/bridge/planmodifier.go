@@ -0,0 +1,119 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure our implementation satisfies the planmodifier.String interface.
+var _ planmodifier.String = remoteURLPlanModifier{}
+
+// remoteURLPlanModifier handles a change of remote.url. A bridge is paired with one specific bridge server, so the
+// bridge is either re-paired in place when a new pairing_token is supplied in the same apply (pairing_token_wo_version
+// changes), or replaced otherwise.
+type remoteURLPlanModifier struct{}
+
+func (m remoteURLPlanModifier) Description(_ context.Context) string {
+	return "Changing the remote URL re-pairs the bridge in place when pairing_token_wo_version changes in the same apply, otherwise the bridge is replaced."
+}
+
+func (m remoteURLPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m remoteURLPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to do on create, destroy, or when the URL is unchanged or not yet known
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !remoteURLChanged(req.StateValue, req.PlanValue) {
+		return
+	}
+
+	var planVersion, stateVersion types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("pairing_token_wo_version"), &planVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("pairing_token_wo_version"), &stateVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !remoteURLRequiresReplace(req.StateValue, req.PlanValue, stateVersion, planVersion) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Bridge will be re-paired in place",
+			fmt.Sprintf("remote.url changes from %q to %q and pairing_token_wo_version changes, so the bridge will be re-paired with the new pairing_token without being replaced.",
+				req.StateValue.ValueString(), req.PlanValue.ValueString()),
+		)
+		return
+	}
+
+	resp.RequiresReplace = true
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Bridge will be replaced",
+		fmt.Sprintf("remote.url changes from %q to %q without a new pairing token, so the bridge will be deleted and created again. "+
+			"Supply a pairing_token generated on the new bridge server. To re-pair the bridge in place instead, change pairing_token_wo_version in the same apply.",
+			req.StateValue.ValueString(), req.PlanValue.ValueString()),
+	)
+}
+
+// remoteURLRequiresReplaceOrRepair returns a plan modifier which replaces the bridge on a remote.url change unless it is
+// re-paired with a new pairing_token in the same apply.
+func remoteURLRequiresReplaceOrRepair() planmodifier.String {
+	return remoteURLPlanModifier{}
+}
+
+// remoteURLChanged reports whether remote.url changes, ignoring a trailing slash the server may add.
+func remoteURLChanged(stateURL, planURL types.String) bool {
+	return !stateURL.IsNull() && !planURL.IsUnknown() && !sameURL(stateURL.ValueString(), planURL.ValueString())
+}
+
+// isRepair reports whether a pairing_token_wo_version change re-pairs the bridge. Setting the version for the first
+// time, e.g. after an import, or removing it does not, as the pairing token in the configuration was already used.
+func isRepair(stateVersion, planVersion types.Int64) bool {
+	return !stateVersion.IsNull() && !planVersion.IsNull() && !planVersion.IsUnknown() && !planVersion.Equal(stateVersion)
+}
+
+// remoteURLRequiresReplace reports whether a remote.url change replaces the bridge, i.e. it is not re-paired in place
+// with a new pairing_token_wo_version.
+func remoteURLRequiresReplace(stateURL, planURL types.String, stateVersion, planVersion types.Int64) bool {
+	return remoteURLChanged(stateURL, planURL) && !isRepair(stateVersion, planVersion)
+}
+
+// bridgeRequiresReplace reports whether the plan replaces an existing bridge. It mirrors the bridge_id and remote.url
+// plan modifiers, whose RequiresReplace result is not visible to the resource ModifyPlan.
+func bridgeRequiresReplace(state, plan BridgeResourceModel) bool {
+	if !plan.BridgeID.Equal(state.BridgeID) {
+		return true
+	}
+	if state.Remote == nil || plan.Remote == nil {
+		return false
+	}
+
+	return remoteURLRequiresReplace(state.Remote.Url, plan.Remote.Url, state.PairingTokenWOVersion, plan.PairingTokenWOVersion)
+}
+
+func addDeletionProtectionError(diags *diag.Diagnostics, bridgeID, action string) {
+	diags.AddAttributeError(
+		path.Root("deletion_protection"),
+		"Bridge is protected from deletion",
+		fmt.Sprintf("Bridge %s has deletion_protection enabled and cannot be %s. "+
+			"Set deletion_protection to false and apply that change on its own before destroying or replacing the bridge.", bridgeID, action),
+	)
+}
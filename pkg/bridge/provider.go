@@ -16,11 +16,14 @@ package bridge
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -48,6 +51,16 @@ type bridgeProviderModel struct {
 	Insecure             types.Bool   `tfsdk:"insecure"`
 	OIDCProviderName     types.String `tfsdk:"oidc_provider_name"`
 	TFCCredentialTagName types.String `tfsdk:"tfc_credential_tag_name"`
+	RetryMaxAttempts     types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryMaxWaitSecs     types.Int64  `tfsdk:"retry_max_wait_secs"`
+	CACertPEM            types.String `tfsdk:"ca_cert_pem"`
+	CACertFile           types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM        types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM         types.String `tfsdk:"client_key_pem"`
+	ProxyURL             types.String `tfsdk:"proxy_url"`
+	ProxyUsername        types.String `tfsdk:"proxy_username"`
+	ProxyPassword        types.String `tfsdk:"proxy_password"`
+	NoProxy              types.List   `tfsdk:"no_proxy"`
 }
 
 func NewProvider() func() provider.Provider {
@@ -60,6 +73,12 @@ func (p *BridgeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// Check environment variables, first available OS variable will be assigned to the var
 	url := util.CheckEnvVars([]string{"JFROG_URL"}, "")
 	accessToken := util.CheckEnvVars([]string{"JFROG_ACCESS_TOKEN"}, "")
+	clientTLS := tlsSettings{
+		CACertPEM:     util.CheckEnvVars([]string{"JFROG_CA_CERT_PEM"}, ""),
+		CACertFile:    util.CheckEnvVars([]string{"JFROG_CA_CERT_FILE"}, ""),
+		ClientCertPEM: util.CheckEnvVars([]string{"JFROG_CLIENT_CERT_PEM"}, ""),
+		ClientKeyPEM:  util.CheckEnvVars([]string{"JFROG_CLIENT_KEY_PEM"}, ""),
+	}
 
 	var config bridgeProviderModel
 
@@ -90,9 +109,62 @@ func (p *BridgeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Skip TLS certificate verification if insecure is true
-	if config.Insecure.ValueBool() {
-		platformClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	retryMaxAttempts := int64(defaultRetryMaxAttempts)
+	if !config.RetryMaxAttempts.IsNull() {
+		retryMaxAttempts = config.RetryMaxAttempts.ValueInt64()
+	}
+	retryMaxWaitSecs := int64(defaultRetryMaxWaitSecs)
+	if !config.RetryMaxWaitSecs.IsNull() {
+		retryMaxWaitSecs = config.RetryMaxWaitSecs.ValueInt64()
+	}
+	configureRetry(platformClient, retryMaxAttempts, time.Duration(retryMaxWaitSecs)*time.Second)
+
+	// configuration takes precedence over environment variables. A CA certificate from the configuration replaces the
+	// one from the environment, whether it is inline or a file.
+	clientTLS.Insecure = config.Insecure.ValueBool()
+	if config.CACertPEM.ValueString() != "" || config.CACertFile.ValueString() != "" {
+		clientTLS.CACertPEM = config.CACertPEM.ValueString()
+		clientTLS.CACertFile = config.CACertFile.ValueString()
+	}
+	if config.ClientCertPEM.ValueString() != "" {
+		clientTLS.ClientCertPEM = config.ClientCertPEM.ValueString()
+	}
+	if config.ClientKeyPEM.ValueString() != "" {
+		clientTLS.ClientKeyPEM = config.ClientKeyPEM.ValueString()
+	}
+
+	clientProxy := proxySettings{
+		URL:      config.ProxyURL.ValueString(),
+		Username: config.ProxyUsername.ValueString(),
+		Password: config.ProxyPassword.ValueString(),
+	}
+	if !config.NoProxy.IsNull() && !config.NoProxy.IsUnknown() {
+		resp.Diagnostics.Append(config.NoProxy.ElementsAs(ctx, &clientProxy.NoProxy, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !clientProxy.isDefault() {
+		if err := configureProxy(platformClient, clientProxy); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Proxy Configuration",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !clientTLS.isDefault() {
+		tlsConfig, err := clientTLS.tlsConfig()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid TLS Configuration",
+				err.Error(),
+			)
+			return
+		}
+		platformClient.SetTLSClientConfig(tlsConfig)
 	}
 
 	oidcProviderName := config.OIDCProviderName.ValueString()
@@ -144,6 +216,7 @@ func (p *BridgeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	// usage telemetry is sent with the platform client, so it goes through the same proxy and TLS settings
 	featureUsage := fmt.Sprintf("Terraform/%s", req.TerraformVersion)
 	go util.SendUsage(ctx, platformClient.R(), productId, featureUsage)
 
@@ -165,7 +238,10 @@ func (p *BridgeProvider) Metadata(ctx context.Context, req provider.MetadataRequ
 }
 
 func (p *BridgeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewBridgeDataSource,
+		NewBridgesDataSource,
+	}
 }
 
 func (p *BridgeProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -196,6 +272,68 @@ func (p *BridgeProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Skip TLS certificate verification. Use with caution; not recommended for production. Defaults to false.",
 			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+				MarkdownDescription: "PEM encoded CA certificate(s) trusted in addition to the system ones when verifying the JFrog Platform, e.g. for a private CA. This can also be sourced from the `JFROG_CA_CERT_PEM` environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Path to a PEM encoded CA certificate bundle, as an alternative to `ca_cert_pem`. This can also be sourced from the `JFROG_CA_CERT_FILE` environment variable.",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Requires `client_key_pem`. This can also be sourced from the `JFROG_CLIENT_CERT_PEM` environment variable.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+				MarkdownDescription: "PEM encoded private key of `client_cert_pem`. This can also be sourced from the `JFROG_CLIENT_KEY_PEM` environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "URL of the outbound proxy used for every request to the JFrog Platform, including usage telemetry, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTPS_PROXY`/`HTTP_PROXY` environment variables.",
+			},
+			"proxy_username": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				MarkdownDescription: "Username to authenticate against the proxy.",
+			},
+			"proxy_password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_username")),
+				},
+				MarkdownDescription: "Password to authenticate against the proxy. Requires `proxy_username`.",
+			},
+			"no_proxy": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				MarkdownDescription: "Hosts, domains (e.g. `.example.com`), IP addresses or CIDR ranges reached without the proxy. Defaults to the `NO_PROXY` environment variable.",
+			},
 			"oidc_provider_name": schema.StringAttribute{
 				Optional: true,
 				Validators: []validator.String{
@@ -210,6 +348,20 @@ func (p *BridgeProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				},
 				Description: "Terraform Cloud Workload Identity Token tag name. Use for generating multiple TFC workload identity tokens. When set, the provider will attempt to use env var with this tag name as suffix. **Note:** this is case sensitive, so if set to `JFROG`, then env var `TFC_WORKLOAD_IDENTITY_TOKEN_JFROG` is used instead of `TFC_WORKLOAD_IDENTITY_TOKEN`. See [Generating Multiple Tokens](https://developer.hashicorp.com/terraform/cloud-docs/workspaces/dynamic-provider-credentials/manual-generation#generating-multiple-tokens) on HCP Terraform for more details.",
 			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				MarkdownDescription: fmt.Sprintf("Maximum number of attempts, including the first one, for idempotent bridge-client API requests (GET, PUT, DELETE) failing with a connection error or a 429, 502, 503 or 504 response. Retries use exponential backoff with jitter and honor the `Retry-After` header. Set to `1` to disable retries. Defaults to `%d`.", defaultRetryMaxAttempts),
+			},
+			"retry_max_wait_secs": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				MarkdownDescription: fmt.Sprintf("Maximum wait in seconds between two attempts, also capping the `Retry-After` delay. Defaults to `%d`.", defaultRetryMaxWaitSecs),
+			},
 		},
 		MarkdownDescription: "The [JFrog](https://jfrog.com/) Bridge provider is used to interact with the Bridge API features. The provider needs to be configured with the proper credentials before it can be used.",
 	}
@@ -0,0 +1,78 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxySettings are the outbound proxy settings of the provider. Settings left empty fall back to the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables.
+type proxySettings struct {
+	URL      string
+	Username string
+	Password string
+	NoProxy  []string
+}
+
+func (s proxySettings) isDefault() bool {
+	return s.URL == "" && s.Username == "" && len(s.NoProxy) == 0
+}
+
+// configureProxy routes the requests of the client, including usage telemetry, through the proxy.
+func configureProxy(client *resty.Client, settings proxySettings) error {
+	config := httpproxy.FromEnvironment()
+	if settings.URL != "" {
+		proxyURL, err := url.Parse(settings.URL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return fmt.Errorf("invalid proxy_url %q, expected a URL such as http://proxy.example.com:3128", settings.URL)
+		}
+		config.HTTPProxy = settings.URL
+		config.HTTPSProxy = settings.URL
+	}
+	if len(settings.NoProxy) > 0 {
+		config.NoProxy = strings.Join(settings.NoProxy, ",")
+	}
+
+	transport, err := client.Transport()
+	if err != nil {
+		return err
+	}
+
+	proxyFunc := config.ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := proxyFunc(req.URL)
+		if err != nil || proxyURL == nil {
+			return proxyURL, err
+		}
+
+		// credentials embedded in the proxy URL take precedence
+		if settings.Username != "" && proxyURL.User == nil {
+			withUser := *proxyURL
+			withUser.User = url.UserPassword(settings.Username, settings.Password)
+			return &withUser, nil
+		}
+
+		return proxyURL, nil
+	}
+
+	return nil
+}
@@ -16,22 +16,43 @@ package bridge
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 )
 
-const bridgeBasePath = "/bridge-client/api/v1/bridges"
+const (
+	bridgeBasePath  = "/bridge-client/api/v1/bridges"
+	bridgeDebugPath = "/bridge-client/api/v1/debug"
+
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
 
 var _ resource.Resource = &BridgeResource{}
 var _ resource.ResourceWithImportState = &BridgeResource{}
+var _ resource.ResourceWithValidateConfig = &BridgeResource{}
+var _ resource.ResourceWithModifyPlan = &BridgeResource{}
 
 func NewBridgeResource() resource.Resource {
 	return &BridgeResource{}
@@ -50,14 +71,17 @@ type bridgeProxyModel struct {
 }
 
 type bridgeRemoteModel struct {
-	Url      types.String      `tfsdk:"url"`
-	Insecure types.Bool        `tfsdk:"insecure"`
-	Proxy    *bridgeProxyModel `tfsdk:"proxy"`
+	Url            types.String      `tfsdk:"url"`
+	Token          types.String      `tfsdk:"token"`
+	TokenWOVersion types.Int64       `tfsdk:"token_wo_version"`
+	Insecure       types.Bool        `tfsdk:"insecure"`
+	Proxy          *bridgeProxyModel `tfsdk:"proxy"`
 }
 
 type bridgeLocalModel struct {
 	Url                types.String `tfsdk:"url"`
 	AnonymousEndpoints types.List   `tfsdk:"anonymous_endpoints"`
+	DialTimeoutSecs    types.Int64  `tfsdk:"dial_timeout_secs"`
 }
 
 type bridgeTargetUsageModel struct {
@@ -72,6 +96,7 @@ type bridgeTunnelCreationJobModel struct {
 type bridgeTunnelClosingJobModel struct {
 	CronExpr              types.String `tfsdk:"cron_expr"`
 	AllowCloseUsedTunnels types.Bool   `tfsdk:"allow_close_used_tunnels"`
+	NextRuns              types.List   `tfsdk:"next_runs"`
 }
 
 type bridgeJobsModel struct {
@@ -80,16 +105,20 @@ type bridgeJobsModel struct {
 }
 
 type BridgeResourceModel struct {
-	ID           types.String            `tfsdk:"id"`
-	BridgeID     types.String            `tfsdk:"bridge_id"`
-	Remote       *bridgeRemoteModel      `tfsdk:"remote"`
-	Local        *bridgeLocalModel       `tfsdk:"local"`
-	PairingToken types.String            `tfsdk:"pairing_token"`
-	MinTunnels   types.Int64             `tfsdk:"min_tunnels"`
-	MaxTunnels   types.Int64             `tfsdk:"max_tunnels"`
-	TargetUsage  *bridgeTargetUsageModel `tfsdk:"target_usage"`
-	Jobs         *bridgeJobsModel        `tfsdk:"jobs"`
-	CreatedAt    types.String            `tfsdk:"created_at"`
+	ID                    types.String            `tfsdk:"id"`
+	BridgeID              types.String            `tfsdk:"bridge_id"`
+	Remote                *bridgeRemoteModel      `tfsdk:"remote"`
+	Local                 *bridgeLocalModel       `tfsdk:"local"`
+	PairingToken          types.String            `tfsdk:"pairing_token"`
+	PairingTokenWOVersion types.Int64             `tfsdk:"pairing_token_wo_version"`
+	MinTunnels            types.Int64             `tfsdk:"min_tunnels"`
+	MaxTunnels            types.Int64             `tfsdk:"max_tunnels"`
+	TargetUsage           *bridgeTargetUsageModel `tfsdk:"target_usage"`
+	Jobs                  *bridgeJobsModel        `tfsdk:"jobs"`
+	CreatedAt             types.String            `tfsdk:"created_at"`
+	DeletionProtection    types.Bool              `tfsdk:"deletion_protection"`
+	AdoptExisting         types.Bool              `tfsdk:"adopt_existing"`
+	Timeouts              timeouts.Value          `tfsdk:"timeouts"`
 }
 
 type bridgeProxyAPIModel struct {
@@ -146,22 +175,92 @@ type bridgeCreateRequestModel struct {
 	PairingToken string `json:"pairing_token"`
 }
 
-// bridgeUpdateRequestModel is for PATCH /bridges/{id} (update) - uses object structures
+// bridgeUpdateRequestModel is for PATCH /bridges/{id} (update) - uses object structures. Optional settings use
+// nullable so a setting removed from the configuration is sent as null and reset to the server default.
 type bridgeUpdateRequestModel struct {
-	Remote      *bridgeRemoteAPIModel      `json:"remote,omitempty"`
-	Local       *bridgeLocalAPIModel       `json:"local,omitempty"`
-	MinTunnels  *int64                     `json:"min_tunnels,omitempty"`
-	MaxTunnels  *int64                     `json:"max_tunnels,omitempty"`
-	TargetUsage *bridgeTargetUsageAPIModel `json:"target_usage,omitempty"`
-	Jobs        *bridgeJobsAPIModel        `json:"jobs,omitempty"`
+	PairingToken string                                `json:"pairing_token,omitempty"`
+	Remote       *bridgeRemotePatchModel               `json:"remote,omitempty"`
+	Local        *bridgeLocalPatchModel                `json:"local,omitempty"`
+	MinTunnels   nullable[int64]                       `json:"min_tunnels,omitzero"`
+	MaxTunnels   nullable[int64]                       `json:"max_tunnels,omitzero"`
+	TargetUsage  nullable[bridgeTargetUsagePatchModel] `json:"target_usage,omitzero"`
+	Jobs         nullable[bridgeJobsPatchModel]        `json:"jobs,omitzero"`
 }
 
+type bridgeProxyPatchModel struct {
+	Enabled            nullable[bool]   `json:"enabled,omitzero"`
+	CacheExpirationSec nullable[int64]  `json:"cache_expiration_secs,omitzero"`
+	Key                nullable[string] `json:"key,omitzero"`
+	SchemeOverride     nullable[string] `json:"scheme_override,omitzero"`
+}
+
+type bridgeRemotePatchModel struct {
+	Url      string                          `json:"url,omitempty"`
+	Token    string                          `json:"token,omitempty"`
+	Insecure nullable[bool]                  `json:"insecure,omitzero"`
+	Proxy    nullable[bridgeProxyPatchModel] `json:"proxy,omitzero"`
+}
+
+type bridgeLocalPatchModel struct {
+	Url                string             `json:"url,omitempty"`
+	AnonymousEndpoints nullable[[]string] `json:"anonymous_endpoints,omitzero"`
+	DialTimeoutSecs    nullable[int64]    `json:"dial_timeout_secs,omitzero"`
+}
+
+type bridgeTargetUsagePatchModel struct {
+	Low  nullable[int64] `json:"low,omitzero"`
+	High nullable[int64] `json:"high,omitzero"`
+}
+
+type bridgeTunnelCreationJobPatchModel struct {
+	IntervalMinutes nullable[int64] `json:"interval_minutes,omitzero"`
+}
+
+type bridgeTunnelClosingJobPatchModel struct {
+	CronExpr              nullable[string] `json:"cron_expr,omitzero"`
+	AllowCloseUsedTunnels nullable[bool]   `json:"allow_close_used_tunnels,omitzero"`
+}
+
+type bridgeJobsPatchModel struct {
+	TunnelCreation nullable[bridgeTunnelCreationJobPatchModel] `json:"tunnel_creation,omitzero"`
+	TunnelClosing  nullable[bridgeTunnelClosingJobPatchModel]  `json:"tunnel_closing,omitzero"`
+}
+
+// nullable is a PATCH field which is either omitted (zero value), sent as an explicit null to reset the setting to
+// the server default, or sent with a value. Unlike omitempty, values such as 0 or false are sent as is.
+type nullable[T any] struct {
+	set   bool
+	value *T
+}
+
+func nullableValue[T any](value T) nullable[T] {
+	return nullable[T]{set: true, value: &value}
+}
+
+func nullableNull[T any]() nullable[T] {
+	return nullable[T]{set: true}
+}
+
+func (n nullable[T]) IsZero() bool {
+	return !n.set
+}
+
+func (n nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.value == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*n.value)
+}
+
+type bridgeDebugEntry struct {
+	ID      string               `json:"id"`
+	Config  bridgeConfigAPIModel `json:"config"`
+	Created string               `json:"created_at"`
+}
+
+// bridgeDebugResponse is for GET /debug - a snapshot of every bridge defined on the bridge client
 type bridgeDebugResponse struct {
-	Bridges []struct {
-		ID      string               `json:"id"`
-		Config  bridgeConfigAPIModel `json:"config"`
-		Created string               `json:"created_at"`
-	} `json:"bridges"`
+	Bridges []bridgeDebugEntry `json:"bridges"`
 }
 
 func (r *BridgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -192,8 +291,24 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Remote (bridge server) configuration.",
 				Attributes: map[string]schema.Attribute{
 					"url": schema.StringAttribute{
-						Required:            true,
-						MarkdownDescription: "URL of the bridge server (remote JPD).",
+						Required: true,
+						PlanModifiers: []planmodifier.String{
+							remoteURLRequiresReplaceOrRepair(),
+						},
+						MarkdownDescription: "URL of the bridge server (remote JPD). Changing this forces a new resource to be created, unless `pairing_token_wo_version` changes in the same apply, in which case the bridge is re-paired in place with the new `pairing_token`.",
+					},
+					"token": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Token used by the bridge client to authenticate against the remote. This is a write-only attribute and is never stored in state; it is sent on create and whenever `token_wo_version` changes. Requires Terraform 1.11 or later.",
+					},
+					"token_wo_version": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("token")),
+						},
+						MarkdownDescription: "Version of `token`. Change this value to send a new `token` to the bridge, e.g. when rotating it.",
 					},
 					"insecure": schema.BoolAttribute{
 						Optional:            true,
@@ -208,7 +323,10 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								MarkdownDescription: "Whether proxy is enabled.",
 							},
 							"cache_expiration_secs": schema.Int64Attribute{
-								Optional:            true,
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
 								MarkdownDescription: "Proxy cache expiration in seconds.",
 							},
 							"key": schema.StringAttribute{
@@ -236,19 +354,40 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						ElementType:         types.StringType,
 						MarkdownDescription: "List of anonymous endpoints allowed through the bridge.",
 					},
+					"dial_timeout_secs": schema.Int64Attribute{
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						MarkdownDescription: "Timeout in seconds when dialing the local JPD. Increase for slow on-prem JPDs.",
+					},
 				},
 			},
 			"pairing_token": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
-				MarkdownDescription: "Pairing token generated on the bridge server. Required on create; removed from state after creation.",
+				WriteOnly:           true,
+				MarkdownDescription: "Pairing token generated on the bridge server. Required on create. This is a write-only attribute and is never stored in state or shown in plan output. Requires Terraform 1.11 or later.",
+			},
+			"pairing_token_wo_version": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("pairing_token")),
+				},
+				MarkdownDescription: "Version of `pairing_token`. Change this value together with a new `pairing_token` to re-pair the existing bridge in place, e.g. after the bridge server was rebuilt or the pairing was revoked. Only a change from one version to another re-pairs the bridge: setting it for the first time, e.g. after an import, or removing it does not.",
 			},
 			"min_tunnels": schema.Int64Attribute{
-				Optional:            true,
-				MarkdownDescription: "Minimum tunnels.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Minimum tunnels. Must not be greater than `max_tunnels`.",
 			},
 			"max_tunnels": schema.Int64Attribute{
-				Optional:            true,
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
 				MarkdownDescription: "Maximum tunnels.",
 			},
 			"target_usage": schema.SingleNestedAttribute{
@@ -257,9 +396,17 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Attributes: map[string]schema.Attribute{
 					"low": schema.Int64Attribute{
 						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						MarkdownDescription: "Low usage threshold. Must be lower than `high`.",
 					},
 					"high": schema.Int64Attribute{
 						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+						MarkdownDescription: "High usage threshold.",
 					},
 				},
 			},
@@ -271,7 +418,10 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						Optional: true,
 						Attributes: map[string]schema.Attribute{
 							"interval_minutes": schema.Int64Attribute{
-								Optional:            true,
+								Optional: true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
 								MarkdownDescription: "Interval in minutes for tunnel creation.",
 							},
 						},
@@ -280,13 +430,21 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						Optional: true,
 						Attributes: map[string]schema.Attribute{
 							"cron_expr": schema.StringAttribute{
-								Optional:            true,
-								MarkdownDescription: "Cron expression for tunnel closing.",
+								Optional: true,
+								Validators: []validator.String{
+									isCronExpr(),
+								},
+								MarkdownDescription: "Cron expression for tunnel closing, in the bridge client dialect: `minute hour day-of-month month day-of-week` (e.g. `0 0 * * *`) or a descriptor such as `@daily` or `@every 6h`. Evaluated in UTC unless prefixed with `CRON_TZ=<timezone>`.",
 							},
 							"allow_close_used_tunnels": schema.BoolAttribute{
 								Optional:            true,
 								MarkdownDescription: "Whether to allow closing used tunnels.",
 							},
+							"next_runs": schema.ListAttribute{
+								Computed:            true,
+								ElementType:         types.StringType,
+								MarkdownDescription: "Next scheduled tunnel closing times (RFC3339) for `cron_expr`, calculated when `cron_expr` changes so the schedule can be reviewed in the plan. It is not recalculated on refresh, so it may list times which have passed.",
+							},
 						},
 					},
 				},
@@ -294,8 +452,27 @@ func (r *BridgeResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"created_at": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Timestamp when the bridge was created (from debug snapshot).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Prevent the bridge from being destroyed or replaced. It must be set to `false` in a separate apply before the bridge can be destroyed or replaced. Defaults to `false`.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When a bridge with the same `bridge_id` already exists on create, take it into state instead of failing, provided its remote URL matches `remote.url`. Its settings are then reconciled with the configuration and `pairing_token` is not required. Defaults to `false`.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -306,8 +483,48 @@ func (r *BridgeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
-func (r *BridgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+func (r *BridgeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BridgeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(config.MinTunnels) && isKnown(config.MaxTunnels) &&
+		config.MinTunnels.ValueInt64() > config.MaxTunnels.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_tunnels"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("min_tunnels (%d) must not be greater than max_tunnels (%d).", config.MinTunnels.ValueInt64(), config.MaxTunnels.ValueInt64()),
+		)
+	}
+
+	if config.TargetUsage != nil && isKnown(config.TargetUsage.Low) && isKnown(config.TargetUsage.High) &&
+		config.TargetUsage.Low.ValueInt64() >= config.TargetUsage.High.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_usage").AtName("low"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf("target_usage.low (%d) must be lower than target_usage.high (%d).", config.TargetUsage.Low.ValueInt64(), config.TargetUsage.High.ValueInt64()),
+		)
+	}
+}
+
+func (r *BridgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state BridgeResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		if state.DeletionProtection.ValueBool() {
+			addDeletionProtectionError(&resp.Diagnostics, state.BridgeID.ValueString(), "destroyed")
+		}
+		return
+	}
 
 	var plan BridgeResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -315,36 +532,194 @@ func (r *BridgeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if plan.PairingToken.IsUnknown() || plan.PairingToken.IsNull() || plan.PairingToken.ValueString() == "" {
-		resp.Diagnostics.AddError(
-			"Missing pairing_token",
-			"pairing_token is required when defining a new bridge. Generate it on the bridge server and supply it on creation.",
-		)
+	// Protection is checked against the prior state, so turning it off only takes effect once applied
+	if !req.State.Raw.IsNull() && state.DeletionProtection.ValueBool() && bridgeRequiresReplace(state, plan) {
+		addDeletionProtectionError(&resp.Diagnostics, state.BridgeID.ValueString(), "replaced")
 		return
 	}
 
-	// Create uses simple URL strings for remote/local
-	payload := bridgeCreateRequestModel{
-		BridgeID:     plan.BridgeID.ValueString(),
-		Remote:       plan.Remote.Url.ValueString(),
-		Local:        plan.Local.Url.ValueString(),
-		PairingToken: plan.PairingToken.ValueString(),
+	if plan.Jobs == nil || plan.Jobs.TunnelClosing == nil {
+		return
 	}
-	response, err := r.ProviderData.Client.R().
-		SetBody(payload).
-		Post(bridgeBasePath)
 
-	if err != nil {
-		utilfw.UnableToCreateResourceError(resp, err.Error())
+	// Keep the previous preview while the schedule is unchanged, so next_runs doesn't show up in every plan
+	nextRunsPath := path.Root("jobs").AtName("tunnel_closing").AtName("next_runs")
+	if state.Jobs != nil && state.Jobs.TunnelClosing != nil &&
+		state.Jobs.TunnelClosing.CronExpr.Equal(plan.Jobs.TunnelClosing.CronExpr) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, nextRunsPath, state.Jobs.TunnelClosing.NextRuns)...)
 		return
 	}
-	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, response.String())
+
+	nextRuns, diags := cronNextRuns(ctx, plan.Jobs.TunnelClosing.CronExpr, time.Now().UTC())
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, nextRunsPath, nextRuns)...)
+}
+
+func (r *BridgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan BridgeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var existing *bridgeDebugEntry
+	if plan.AdoptExisting.ValueBool() {
+		bridge, err := getBridge(ctx, r.ProviderData.Client, plan.BridgeID.ValueString())
+		if err != nil {
+			if !addRequestError(ctx, &resp.Diagnostics, err) {
+				utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("looking up an existing bridge failed: %s", err))
+			}
+			return
+		}
+		existing = bridge
+	}
+
+	// prior holds the settings the bridge client already has, so the follow-up PATCH only sends the difference
+	var prior BridgeResourceModel
+	action := "created and paired"
+	if existing != nil {
+		var existingURL string
+		if existing.Config.Remote != nil {
+			existingURL = existing.Config.Remote.Url
+		}
+		if !sameURL(existingURL, plan.Remote.Url.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("remote").AtName("url"),
+				"Existing bridge has a different remote URL",
+				fmt.Sprintf("Bridge %s already exists but is paired with %q instead of %q, so it was not adopted. "+
+					"Delete it or import it and change remote.url to re-pair it.", plan.BridgeID.ValueString(), existingURL, plan.Remote.Url.ValueString()),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Adopting existing bridge", map[string]interface{}{
+			"bridge_id": plan.BridgeID.ValueString(),
+		})
+		// Start from the plan so only the attributes managed by the configuration are reconciled, settings made out of
+		// band (e.g. a proxy set in the UI) are left as is
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(prior.fromAPIModel(ctx, *existing, false)...)
+		resp.Diagnostics.Append(prior.resolveNextRuns(ctx)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		action = "adopted"
+	} else {
+		// pairing_token is write-only so it is only available from the configuration
+		var pairingToken types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pairing_token"), &pairingToken)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if pairingToken.IsUnknown() || pairingToken.IsNull() || pairingToken.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Missing pairing_token",
+				"pairing_token is required when defining a new bridge. Generate it on the bridge server and supply it on creation.",
+			)
+			return
+		}
+
+		// Create uses simple URL strings for remote/local
+		payload := bridgeCreateRequestModel{
+			BridgeID:     plan.BridgeID.ValueString(),
+			Remote:       plan.Remote.Url.ValueString(),
+			Local:        plan.Local.Url.ValueString(),
+			PairingToken: pairingToken.ValueString(),
+		}
+		response, err := r.ProviderData.Client.R().
+			SetContext(ctx).
+			SetBody(payload).
+			Post(bridgeBasePath)
+
+		if err != nil {
+			if !addRequestError(ctx, &resp.Diagnostics, err) {
+				utilfw.UnableToCreateResourceError(resp, err.Error())
+			}
+			return
+		}
+		if response.IsError() {
+			if apiErr := newBridgeAPIError(response); !addBridgeAPIError(&resp.Diagnostics, apiErr) {
+				utilfw.UnableToCreateResourceError(resp, apiErr.Error())
+			}
+			return
+		}
+
+		// POST only accepts the URLs and pairing token, the remaining settings (tunnels, target usage, jobs, remote
+		// insecure/proxy/token and local anonymous endpoints/dial timeout) are applied with the follow-up PATCH
+		prior = BridgeResourceModel{
+			Remote: &bridgeRemoteModel{
+				Url: plan.Remote.Url,
+			},
+			Local: &bridgeLocalModel{
+				Url:                plan.Local.Url,
+				AnonymousEndpoints: types.ListNull(types.StringType),
+			},
+		}
+	}
+
 	plan.ID = plan.BridgeID
 	plan.CreatedAt = types.StringNull()
+	resp.Diagnostics.Append(plan.resolveNextRuns(ctx)...)
+
+	// remote.token is write-only so it is only available from the configuration
+	var remoteToken types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote").AtName("token"), &remoteToken)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch, diags := buildUpdateRequest(ctx, plan, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	patch.setRemoteToken(remoteToken.ValueString())
+	if err := r.patchBridgeIfChanged(ctx, plan.BridgeID.ValueString(), patch); err != nil {
+		// field errors of server-side validation, e.g. jobs.tunnel_closing.cron_expr, are reported on their attribute
+		detail := fmt.Sprintf("The bridge %s was %s, but applying its settings failed. It has been saved to state with its current "+
+			"settings and Terraform marks it as tainted, so the next apply would replace it, which needs a new pairing token. "+
+			"Fix the error, run terraform untaint on this resource, then apply again to apply the remaining settings as an update.",
+			plan.BridgeID.ValueString(), action)
+		if !addRequestError(ctx, &resp.Diagnostics, err) {
+			detail = fmt.Sprintf("%s\n\nError: %s", detail, err)
+		}
+		resp.Diagnostics.AddError("Bridge settings not applied", detail)
+
+		// Save the settings the bridge actually has, as Read would, so the untainted resource is updated with the
+		// remaining ones. When the bridge can't be read, the refresh before the next apply corrects them.
+		if bridge, err := getBridge(ctx, r.ProviderData.Client, plan.BridgeID.ValueString()); err == nil && bridge != nil {
+			resp.Diagnostics.Append(plan.fromAPIModel(ctx, *bridge, false)...)
+		}
+		// the remote token was not sent, leave its version unset so the next apply sends it
+		plan.Remote.TokenWOVersion = types.Int64Null()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	bridge, err := getBridge(ctx, r.ProviderData.Client, plan.BridgeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read bridge after creation",
+			fmt.Sprintf("The bridge was %s but its debug snapshot could not be read, created_at will be populated on next refresh. Error: %s", action, err),
+		)
+	} else if bridge != nil {
+		plan.CreatedAt = types.StringValue(bridge.Created)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -358,7 +733,41 @@ func (r *BridgeResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Without the debug endpoint, keep existing state. User-driven updates will reconcile configuration.
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	bridge, err := getBridge(ctx, r.ProviderData.Client, state.BridgeID.ValueString())
+	if err != nil {
+		if !addRequestError(ctx, &resp.Diagnostics, err) {
+			utilfw.UnableToRefreshResourceError(resp, err.Error())
+		}
+		return
+	}
+
+	// Bridge was removed outside of Terraform, remove it from state so the next plan recreates it
+	if bridge == nil {
+		tflog.Warn(ctx, "Bridge not found on the bridge client, removing it from state", map[string]any{
+			"bridge_id": state.BridgeID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// remote is required, so it is only missing from state right after an import. Populate every attribute returned
+	// by the server in that case so the imported resource matches its configuration.
+	importAll := state.Remote == nil
+
+	resp.Diagnostics.Append(state.fromAPIModel(ctx, *bridge, importAll)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -373,31 +782,59 @@ func (r *BridgeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if !plan.PairingToken.IsNull() && plan.PairingToken.ValueString() != "" {
-		resp.Diagnostics.AddWarning(
-			"pairing_token ignored on update",
-			"pairing_token is only used when defining a new bridge and will not be sent during updates.",
-		)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	plan.ID = state.ID
 	plan.BridgeID = state.BridgeID
-	plan.PairingToken = state.PairingToken // preserve from state
 	plan.CreatedAt = state.CreatedAt
+	resp.Diagnostics.Append(plan.resolveNextRuns(ctx)...)
 
 	// Update uses object structures for remote/local
-	payload := buildUpdateRequest(plan)
-	endpoint := fmt.Sprintf("%s/%s", bridgeBasePath, plan.BridgeID.ValueString())
-
-	response, err := r.ProviderData.Client.R().
-		SetBody(payload).
-		Patch(endpoint)
-	if err != nil {
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
+	patch, diags := buildUpdateRequest(ctx, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, response.String())
+
+	// pairing_token is write-only, re-pair the bridge with it when its version changes
+	if isRepair(state.PairingTokenWOVersion, plan.PairingTokenWOVersion) {
+		var pairingToken types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pairing_token"), &pairingToken)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if pairingToken.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("pairing_token"),
+				"Missing pairing_token",
+				"pairing_token_wo_version changed but no pairing_token was supplied. Generate a new pairing token on the bridge server and supply it together with the new version to re-pair the bridge.",
+			)
+			return
+		}
+		patch.PairingToken = pairingToken.ValueString()
+	}
+
+	// remote.token is write-only, only send it when its version changes
+	if state.Remote == nil || !plan.Remote.TokenWOVersion.Equal(state.Remote.TokenWOVersion) {
+		var remoteToken types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote").AtName("token"), &remoteToken)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		patch.setRemoteToken(remoteToken.ValueString())
+	}
+
+	if err := r.patchBridgeIfChanged(ctx, plan.BridgeID.ValueString(), patch); err != nil {
+		if !addRequestError(ctx, &resp.Diagnostics, err) {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+		}
 		return
 	}
 
@@ -413,16 +850,42 @@ func (r *BridgeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		addDeletionProtectionError(&resp.Diagnostics, state.BridgeID.ValueString(), "destroyed")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	endpoint := fmt.Sprintf("%s/%s", bridgeBasePath, state.BridgeID.ValueString())
 	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
 		Delete(endpoint)
 
 	if err != nil {
-		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		if !addRequestError(ctx, &resp.Diagnostics, err) {
+			utilfw.UnableToDeleteResourceError(resp, err.Error())
+		}
+		return
+	}
+	// Bridge was already removed outside of Terraform
+	if response.StatusCode() == http.StatusNotFound {
+		tflog.Warn(ctx, "Bridge not found on the bridge client, assuming it was already deleted", map[string]any{
+			"bridge_id": state.BridgeID.ValueString(),
+		})
 		return
 	}
 	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, response.String())
+		if apiErr := newBridgeAPIError(response); !addBridgeAPIError(&resp.Diagnostics, apiErr) {
+			utilfw.UnableToDeleteResourceError(resp, apiErr.Error())
+		}
 		return
 	}
 }
@@ -431,93 +894,410 @@ func (r *BridgeResource) ImportState(ctx context.Context, req resource.ImportSta
 	resource.ImportStatePassthroughID(ctx, path.Root("bridge_id"), req, resp)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_at"), types.StringNull())...)
+	// remaining attributes (remote, local, tunnels, target usage and jobs) are populated by Read from the debug snapshot
 }
 
-func buildUpdateRequest(model BridgeResourceModel) bridgeUpdateRequestModel {
-	req := bridgeUpdateRequestModel{}
+// isKnown returns true when the value is neither null nor unknown, i.e. it can be validated.
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
 
-	if !model.MinTunnels.IsNull() && model.MinTunnels.ValueInt64() != 0 {
-		val := model.MinTunnels.ValueInt64()
-		req.MinTunnels = &val
+// patchBridgeIfChanged sends a PATCH /bridges/{id} with the given payload. Nothing is sent when the payload is empty.
+func (r *BridgeResource) patchBridgeIfChanged(ctx context.Context, bridgeID string, payload bridgeUpdateRequestModel) error {
+	if payload.isEmpty() {
+		return nil
 	}
-	if !model.MaxTunnels.IsNull() && model.MaxTunnels.ValueInt64() != 0 {
-		val := model.MaxTunnels.ValueInt64()
-		req.MaxTunnels = &val
+
+	endpoint := fmt.Sprintf("%s/%s", bridgeBasePath, bridgeID)
+	response, err := r.ProviderData.Client.R().
+		SetContext(ctx).
+		SetBody(payload).
+		Patch(endpoint)
+	if err != nil {
+		return err
 	}
-	if model.TargetUsage != nil {
-		req.TargetUsage = &bridgeTargetUsageAPIModel{}
-		if !model.TargetUsage.Low.IsNull() {
-			val := model.TargetUsage.Low.ValueInt64()
-			req.TargetUsage.Low = &val
+	if response.IsError() {
+		return newBridgeAPIError(response)
+	}
+
+	return nil
+}
+
+// sameURL compares two URLs ignoring a trailing slash.
+func sameURL(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+// listBridges returns every bridge defined on the bridge client from the debug snapshot.
+func listBridges(ctx context.Context, client *resty.Client) ([]bridgeDebugEntry, error) {
+	var result bridgeDebugResponse
+	response, err := client.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Get(bridgeDebugPath)
+	if err != nil {
+		return nil, err
+	}
+	if response.IsError() {
+		return nil, newBridgeAPIError(response)
+	}
+
+	return result.Bridges, nil
+}
+
+// getBridge looks up a single bridge in the debug snapshot. Returns nil without error when the bridge does not exist.
+func getBridge(ctx context.Context, client *resty.Client, bridgeID string) (*bridgeDebugEntry, error) {
+	bridges, err := listBridges(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bridge := range bridges {
+		if bridge.ID == bridgeID {
+			return &bridge, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// fromAPIModel refreshes the model from the bridge config returned by the server. Optional attributes which are not
+// set in the current model are left untouched so server-side defaults don't show up as drift, unless importAll is set
+// in which case every attribute returned by the server is populated.
+func (m *BridgeResourceModel) fromAPIModel(ctx context.Context, bridge bridgeDebugEntry, importAll bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	config := bridge.Config
+	refresh := bridgeRefresher{importAll: importAll}
+
+	m.ID = types.StringValue(bridge.ID)
+	m.BridgeID = types.StringValue(bridge.ID)
+	m.CreatedAt = types.StringValue(bridge.Created)
+
+	m.MinTunnels = refresh.int64(m.MinTunnels, config.MinTunnels)
+	m.MaxTunnels = refresh.int64(m.MaxTunnels, config.MaxTunnels)
+
+	if m.TargetUsage == nil && importAll && config.TargetUsage != nil {
+		m.TargetUsage = &bridgeTargetUsageModel{}
+	}
+	if m.TargetUsage != nil {
+		targetUsage := config.TargetUsage
+		if targetUsage == nil {
+			targetUsage = &bridgeTargetUsageAPIModel{}
+		}
+		m.TargetUsage.Low = refresh.int64(m.TargetUsage.Low, targetUsage.Low)
+		m.TargetUsage.High = refresh.int64(m.TargetUsage.High, targetUsage.High)
+	}
+
+	if m.Jobs == nil && importAll && config.Jobs != nil {
+		m.Jobs = &bridgeJobsModel{}
+	}
+	if m.Jobs != nil {
+		jobs := config.Jobs
+		if jobs == nil {
+			jobs = &bridgeJobsAPIModel{}
+		}
+		if m.Jobs.TunnelCreation == nil && importAll && jobs.TunnelCreation != nil {
+			m.Jobs.TunnelCreation = &bridgeTunnelCreationJobModel{}
+		}
+		if m.Jobs.TunnelCreation != nil {
+			var interval *int64
+			if jobs.TunnelCreation != nil {
+				interval = jobs.TunnelCreation.IntervalMinutes
+			}
+			m.Jobs.TunnelCreation.IntervalMinutes = refresh.int64(m.Jobs.TunnelCreation.IntervalMinutes, interval)
+		}
+		if m.Jobs.TunnelClosing == nil && importAll && jobs.TunnelClosing != nil {
+			m.Jobs.TunnelClosing = &bridgeTunnelClosingJobModel{
+				NextRuns: types.ListNull(types.StringType),
+			}
+		}
+		if m.Jobs.TunnelClosing != nil {
+			var cronExpr string
+			var allowCloseUsedTunnels *bool
+			if jobs.TunnelClosing != nil {
+				cronExpr = jobs.TunnelClosing.CronExpr
+				allowCloseUsedTunnels = jobs.TunnelClosing.AllowCloseUsedTunnels
+			}
+			previousCronExpr := m.Jobs.TunnelClosing.CronExpr
+			m.Jobs.TunnelClosing.CronExpr = refresh.string(m.Jobs.TunnelClosing.CronExpr, cronExpr)
+			// next_runs is only calculated at plan time, drop the preview of a schedule changed outside of Terraform
+			if !m.Jobs.TunnelClosing.CronExpr.Equal(previousCronExpr) {
+				m.Jobs.TunnelClosing.NextRuns = types.ListNull(types.StringType)
+			}
+			m.Jobs.TunnelClosing.AllowCloseUsedTunnels = refresh.bool(m.Jobs.TunnelClosing.AllowCloseUsedTunnels, allowCloseUsedTunnels)
 		}
-		if !model.TargetUsage.High.IsNull() {
-			val := model.TargetUsage.High.ValueInt64()
-			req.TargetUsage.High = &val
+	}
+
+	remote := config.Remote
+	if remote == nil {
+		remote = &bridgeRemoteAPIModel{}
+	}
+	if m.Remote == nil {
+		m.Remote = &bridgeRemoteModel{}
+	}
+	m.Remote.Url = refreshURL(m.Remote.Url, remote.Url)
+	m.Remote.Insecure = refresh.bool(m.Remote.Insecure, remote.Insecure)
+	if m.Remote.Proxy == nil && importAll && remote.Proxy != nil {
+		m.Remote.Proxy = &bridgeProxyModel{}
+	}
+	if m.Remote.Proxy != nil {
+		proxy := remote.Proxy
+		if proxy == nil {
+			proxy = &bridgeProxyAPIModel{}
 		}
+		m.Remote.Proxy.Enabled = refresh.bool(m.Remote.Proxy.Enabled, proxy.Enabled)
+		m.Remote.Proxy.CacheExpirationSecs = refresh.int64(m.Remote.Proxy.CacheExpirationSecs, proxy.CacheExpirationSec)
+		m.Remote.Proxy.Key = refresh.string(m.Remote.Proxy.Key, proxy.Key)
+		m.Remote.Proxy.SchemeOverride = refresh.string(m.Remote.Proxy.SchemeOverride, proxy.SchemeOverride)
 	}
+
+	local := config.Local
+	if local == nil {
+		local = &bridgeLocalAPIModel{}
+	}
+	if m.Local == nil {
+		m.Local = &bridgeLocalModel{
+			AnonymousEndpoints: types.ListNull(types.StringType),
+		}
+	}
+	m.Local.Url = refreshURL(m.Local.Url, local.Url)
+	m.Local.DialTimeoutSecs = refresh.int64(m.Local.DialTimeoutSecs, local.DialTimeoutSecs)
+	if !m.Local.AnonymousEndpoints.IsNull() || (importAll && len(local.AnonymousEndpoints) > 0) {
+		anonymousEndpoints := local.AnonymousEndpoints
+		if anonymousEndpoints == nil {
+			anonymousEndpoints = []string{}
+		}
+		endpoints, d := types.ListValueFrom(ctx, types.StringType, anonymousEndpoints)
+		diags.Append(d...)
+		m.Local.AnonymousEndpoints = endpoints
+	}
+
+	return diags
+}
+
+// resolveNextRuns calculates jobs.tunnel_closing.next_runs when it could not be calculated at plan time, i.e. when
+// cron_expr was unknown.
+func (m *BridgeResourceModel) resolveNextRuns(ctx context.Context) diag.Diagnostics {
+	if m.Jobs == nil || m.Jobs.TunnelClosing == nil || !m.Jobs.TunnelClosing.NextRuns.IsUnknown() {
+		return nil
+	}
+
+	nextRuns, diags := cronNextRuns(ctx, m.Jobs.TunnelClosing.CronExpr, time.Now().UTC())
+	m.Jobs.TunnelClosing.NextRuns = nextRuns
+	return diags
+}
+
+// refreshURL keeps the current URL when the server only normalized it, e.g. by adding a trailing slash, so it doesn't
+// show up as drift.
+func refreshURL(current types.String, remote string) types.String {
+	if !current.IsNull() && !current.IsUnknown() && sameURL(current.ValueString(), remote) {
+		return current
+	}
+	return types.StringValue(remote)
+}
+
+// bridgeRefresher maps server values onto optional attributes. Attributes which are null in the current model are
+// treated as unmanaged and kept null, unless importAll is set.
+type bridgeRefresher struct {
+	importAll bool
+}
+
+func (r bridgeRefresher) int64(current types.Int64, remote *int64) types.Int64 {
+	if current.IsNull() && !r.importAll {
+		return current
+	}
+	return types.Int64PointerValue(remote)
+}
+
+func (r bridgeRefresher) bool(current types.Bool, remote *bool) types.Bool {
+	if current.IsNull() && !r.importAll {
+		return current
+	}
+	return types.BoolPointerValue(remote)
+}
+
+func (r bridgeRefresher) string(current types.String, remote string) types.String {
+	if current.IsNull() {
+		if !r.importAll || remote == "" {
+			return current
+		}
+	}
+	return types.StringValue(remote)
+}
+
+// buildUpdateRequest builds a minimal PATCH payload containing only the attributes which differ between the plan and
+// prior (the current state), so settings changed out-of-band on attributes Terraform doesn't manage are left alone.
+// Optional settings removed from the plan are sent as null so the bridge resets them to the server default.
+func buildUpdateRequest(ctx context.Context, model BridgeResourceModel, prior BridgeResourceModel) (bridgeUpdateRequestModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	req := bridgeUpdateRequestModel{
+		MinTunnels: patchInt64(model.MinTunnels, prior.MinTunnels),
+		MaxTunnels: patchInt64(model.MaxTunnels, prior.MaxTunnels),
+	}
+
+	targetUsage := bridgeTargetUsagePatchModel{}
+	if model.TargetUsage != nil {
+		priorTargetUsage := prior.TargetUsage
+		if priorTargetUsage == nil {
+			priorTargetUsage = &bridgeTargetUsageModel{}
+		}
+		targetUsage.Low = patchInt64(model.TargetUsage.Low, priorTargetUsage.Low)
+		targetUsage.High = patchInt64(model.TargetUsage.High, priorTargetUsage.High)
+	}
+	req.TargetUsage = patchObject(targetUsage, model.TargetUsage != nil, prior.TargetUsage != nil)
+
+	jobs := bridgeJobsPatchModel{}
 	if model.Jobs != nil {
-		req.Jobs = &bridgeJobsAPIModel{}
+		priorJobs := prior.Jobs
+		if priorJobs == nil {
+			priorJobs = &bridgeJobsModel{}
+		}
+
+		tunnelCreation := bridgeTunnelCreationJobPatchModel{}
 		if model.Jobs.TunnelCreation != nil {
-			req.Jobs.TunnelCreation = &struct {
-				IntervalMinutes *int64 `json:"interval_minutes,omitempty"`
-			}{}
-			if !model.Jobs.TunnelCreation.IntervalMinutes.IsNull() {
-				val := model.Jobs.TunnelCreation.IntervalMinutes.ValueInt64()
-				req.Jobs.TunnelCreation.IntervalMinutes = &val
+			priorTunnelCreation := priorJobs.TunnelCreation
+			if priorTunnelCreation == nil {
+				priorTunnelCreation = &bridgeTunnelCreationJobModel{}
 			}
+			tunnelCreation.IntervalMinutes = patchInt64(model.Jobs.TunnelCreation.IntervalMinutes, priorTunnelCreation.IntervalMinutes)
 		}
+		jobs.TunnelCreation = patchObject(tunnelCreation, model.Jobs.TunnelCreation != nil, priorJobs.TunnelCreation != nil)
+
+		tunnelClosing := bridgeTunnelClosingJobPatchModel{}
 		if model.Jobs.TunnelClosing != nil {
-			req.Jobs.TunnelClosing = &struct {
-				CronExpr              string `json:"cron_expr,omitempty"`
-				AllowCloseUsedTunnels *bool  `json:"allow_close_used_tunnels,omitempty"`
-			}{}
-			if model.Jobs.TunnelClosing.CronExpr.ValueString() != "" {
-				req.Jobs.TunnelClosing.CronExpr = model.Jobs.TunnelClosing.CronExpr.ValueString()
-			}
-			if !model.Jobs.TunnelClosing.AllowCloseUsedTunnels.IsNull() {
-				val := model.Jobs.TunnelClosing.AllowCloseUsedTunnels.ValueBool()
-				req.Jobs.TunnelClosing.AllowCloseUsedTunnels = &val
+			priorTunnelClosing := priorJobs.TunnelClosing
+			if priorTunnelClosing == nil {
+				priorTunnelClosing = &bridgeTunnelClosingJobModel{}
 			}
+			tunnelClosing.CronExpr = patchString(model.Jobs.TunnelClosing.CronExpr, priorTunnelClosing.CronExpr)
+			tunnelClosing.AllowCloseUsedTunnels = patchBool(model.Jobs.TunnelClosing.AllowCloseUsedTunnels, priorTunnelClosing.AllowCloseUsedTunnels)
 		}
+		jobs.TunnelClosing = patchObject(tunnelClosing, model.Jobs.TunnelClosing != nil, priorJobs.TunnelClosing != nil)
 	}
+	req.Jobs = patchObject(jobs, model.Jobs != nil, prior.Jobs != nil)
 
 	if model.Remote != nil {
-		req.Remote = &bridgeRemoteAPIModel{
-			Url: model.Remote.Url.ValueString(),
+		priorRemote := prior.Remote
+		if priorRemote == nil {
+			priorRemote = &bridgeRemoteModel{}
 		}
-		if !model.Remote.Insecure.IsNull() {
-			val := model.Remote.Insecure.ValueBool()
-			req.Remote.Insecure = &val
+		remote := bridgeRemotePatchModel{
+			Insecure: patchBool(model.Remote.Insecure, priorRemote.Insecure),
 		}
+		if !model.Remote.Url.Equal(priorRemote.Url) {
+			remote.Url = model.Remote.Url.ValueString()
+		}
+
+		proxy := bridgeProxyPatchModel{}
 		if model.Remote.Proxy != nil {
-			req.Remote.Proxy = &bridgeProxyAPIModel{}
-			if !model.Remote.Proxy.Enabled.IsNull() {
-				val := model.Remote.Proxy.Enabled.ValueBool()
-				req.Remote.Proxy.Enabled = &val
-			}
-			if !model.Remote.Proxy.CacheExpirationSecs.IsNull() {
-				val := model.Remote.Proxy.CacheExpirationSecs.ValueInt64()
-				req.Remote.Proxy.CacheExpirationSec = &val
-			}
-			if model.Remote.Proxy.Key.ValueString() != "" {
-				req.Remote.Proxy.Key = model.Remote.Proxy.Key.ValueString()
-			}
-			if model.Remote.Proxy.SchemeOverride.ValueString() != "" {
-				req.Remote.Proxy.SchemeOverride = model.Remote.Proxy.SchemeOverride.ValueString()
+			priorProxy := priorRemote.Proxy
+			if priorProxy == nil {
+				priorProxy = &bridgeProxyModel{}
 			}
+			proxy.Enabled = patchBool(model.Remote.Proxy.Enabled, priorProxy.Enabled)
+			proxy.CacheExpirationSec = patchInt64(model.Remote.Proxy.CacheExpirationSecs, priorProxy.CacheExpirationSecs)
+			proxy.Key = patchString(model.Remote.Proxy.Key, priorProxy.Key)
+			proxy.SchemeOverride = patchString(model.Remote.Proxy.SchemeOverride, priorProxy.SchemeOverride)
+		}
+		remote.Proxy = patchObject(proxy, model.Remote.Proxy != nil, priorRemote.Proxy != nil)
+
+		if remote != (bridgeRemotePatchModel{}) {
+			req.Remote = &remote
 		}
 	}
 
 	if model.Local != nil {
-		req.Local = &bridgeLocalAPIModel{
-			Url: model.Local.Url.ValueString(),
+		priorLocal := prior.Local
+		if priorLocal == nil {
+			priorLocal = &bridgeLocalModel{
+				AnonymousEndpoints: types.ListNull(types.StringType),
+			}
+		}
+		local := bridgeLocalPatchModel{
+			DialTimeoutSecs: patchInt64(model.Local.DialTimeoutSecs, priorLocal.DialTimeoutSecs),
+		}
+		if !model.Local.Url.Equal(priorLocal.Url) {
+			local.Url = model.Local.Url.ValueString()
+		}
+		if !model.Local.AnonymousEndpoints.Equal(priorLocal.AnonymousEndpoints) {
+			if !model.Local.AnonymousEndpoints.IsNull() {
+				endpoints := []string{}
+				diags.Append(model.Local.AnonymousEndpoints.ElementsAs(ctx, &endpoints, false)...)
+				local.AnonymousEndpoints = nullableValue(endpoints)
+			} else {
+				local.AnonymousEndpoints = nullableNull[[]string]()
+			}
 		}
-		if !model.Local.AnonymousEndpoints.IsNull() && model.Local.AnonymousEndpoints.Elements() != nil {
-			var endpoints []string
-			_ = model.Local.AnonymousEndpoints.ElementsAs(context.Background(), &endpoints, false)
-			req.Local.AnonymousEndpoints = endpoints
+
+		if local != (bridgeLocalPatchModel{}) {
+			req.Local = &local
+		}
+	}
+
+	return req, diags
+}
+
+// setRemoteToken adds the write-only remote token to the payload.
+func (r *bridgeUpdateRequestModel) setRemoteToken(token string) {
+	if token == "" {
+		return
+	}
+	if r.Remote == nil {
+		r.Remote = &bridgeRemotePatchModel{}
+	}
+	r.Remote.Token = token
+}
+
+func (r bridgeUpdateRequestModel) isEmpty() bool {
+	return r.PairingToken == "" && r.Remote == nil && r.Local == nil &&
+		r.MinTunnels.IsZero() && r.MaxTunnels.IsZero() && r.TargetUsage.IsZero() && r.Jobs.IsZero()
+}
+
+// patchObject returns the nested object when any of its attributes changed, or null when the object was removed from
+// the configuration.
+func patchObject[T comparable](value T, planSet, priorSet bool) nullable[T] {
+	var unchanged T
+	if planSet {
+		if value == unchanged {
+			return nullable[T]{}
 		}
+		return nullableValue(value)
+	}
+	if priorSet {
+		return nullableNull[T]()
+	}
+	return nullable[T]{}
+}
+
+// patchInt64 returns the planned value when it changed, or null when the attribute was removed from the configuration.
+func patchInt64(plan, prior types.Int64) nullable[int64] {
+	if plan.Equal(prior) {
+		return nullable[int64]{}
+	}
+	if plan.IsNull() {
+		return nullableNull[int64]()
+	}
+	return nullableValue(plan.ValueInt64())
+}
+
+// patchBool returns the planned value when it changed, or null when the attribute was removed from the configuration.
+func patchBool(plan, prior types.Bool) nullable[bool] {
+	if plan.Equal(prior) {
+		return nullable[bool]{}
+	}
+	if plan.IsNull() {
+		return nullableNull[bool]()
 	}
+	return nullableValue(plan.ValueBool())
+}
 
-	return req
+// patchString returns the planned value when it changed, or null when the attribute was removed from the configuration.
+func patchString(plan, prior types.String) nullable[string] {
+	if plan.Equal(prior) {
+		return nullable[string]{}
+	}
+	if plan.IsNull() {
+		return nullableNull[string]()
+	}
+	return nullableValue(plan.ValueString())
 }
@@ -0,0 +1,332 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testBridgeModel() BridgeResourceModel {
+	return BridgeResourceModel{
+		BridgeID: types.StringValue("demo"),
+		Remote: &bridgeRemoteModel{
+			Url:      types.StringValue("https://remote.example.com"),
+			Insecure: types.BoolValue(true),
+			Proxy: &bridgeProxyModel{
+				Enabled:             types.BoolValue(true),
+				CacheExpirationSecs: types.Int64Value(3600),
+				Key:                 types.StringValue("platform"),
+				SchemeOverride:      types.StringNull(),
+			},
+		},
+		Local: &bridgeLocalModel{
+			Url: types.StringValue("https://local.example.com:8082"),
+			AnonymousEndpoints: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue(".*/system/ping"),
+			}),
+			DialTimeoutSecs: types.Int64Null(),
+		},
+		MinTunnels: types.Int64Value(2),
+		MaxTunnels: types.Int64Value(10),
+		TargetUsage: &bridgeTargetUsageModel{
+			Low:  types.Int64Value(1),
+			High: types.Int64Value(5),
+		},
+		Jobs: &bridgeJobsModel{
+			TunnelCreation: &bridgeTunnelCreationJobModel{
+				IntervalMinutes: types.Int64Value(1),
+			},
+			TunnelClosing: &bridgeTunnelClosingJobModel{
+				CronExpr:              types.StringValue("0 0 * * *"),
+				AllowCloseUsedTunnels: types.BoolValue(true),
+			},
+		},
+	}
+}
+
+func TestBuildUpdateRequest(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(model *BridgeResourceModel)
+		want   string
+	}{
+		{
+			name:   "unchanged",
+			modify: func(_ *BridgeResourceModel) {},
+			want:   `{}`,
+		},
+		{
+			name:   "changed top level setting",
+			modify: func(model *BridgeResourceModel) { model.MinTunnels = types.Int64Value(3) },
+			want:   `{"min_tunnels":3}`,
+		},
+		{
+			name:   "changed nested setting",
+			modify: func(model *BridgeResourceModel) { model.TargetUsage.Low = types.Int64Value(2) },
+			want:   `{"target_usage":{"low":2}}`,
+		},
+		{
+			name:   "changed deeply nested setting",
+			modify: func(model *BridgeResourceModel) { model.Jobs.TunnelClosing.CronExpr = types.StringValue("@daily") },
+			want:   `{"jobs":{"tunnel_closing":{"cron_expr":"@daily"}}}`,
+		},
+		{
+			name:   "changed remote url",
+			modify: func(model *BridgeResourceModel) { model.Remote.Url = types.StringValue("https://other.example.com") },
+			want:   `{"remote":{"url":"https://other.example.com"}}`,
+		},
+		{
+			name:   "zero int value is sent",
+			modify: func(model *BridgeResourceModel) { model.MaxTunnels = types.Int64Value(0) },
+			want:   `{"max_tunnels":0}`,
+		},
+		{
+			name:   "false bool value is sent",
+			modify: func(model *BridgeResourceModel) { model.Remote.Insecure = types.BoolValue(false) },
+			want:   `{"remote":{"insecure":false}}`,
+		},
+		{
+			name:   "empty string value is sent",
+			modify: func(model *BridgeResourceModel) { model.Remote.Proxy.Key = types.StringValue("") },
+			want:   `{"remote":{"proxy":{"key":""}}}`,
+		},
+		{
+			name:   "removed top level setting is reset",
+			modify: func(model *BridgeResourceModel) { model.MinTunnels = types.Int64Null() },
+			want:   `{"min_tunnels":null}`,
+		},
+		{
+			name:   "removed nested setting is reset",
+			modify: func(model *BridgeResourceModel) { model.TargetUsage.High = types.Int64Null() },
+			want:   `{"target_usage":{"high":null}}`,
+		},
+		{
+			name:   "removed object is reset",
+			modify: func(model *BridgeResourceModel) { model.TargetUsage = nil },
+			want:   `{"target_usage":null}`,
+		},
+		{
+			name:   "removed nested object is reset",
+			modify: func(model *BridgeResourceModel) { model.Remote.Proxy = nil },
+			want:   `{"remote":{"proxy":null}}`,
+		},
+		{
+			name:   "removed list is reset",
+			modify: func(model *BridgeResourceModel) { model.Local.AnonymousEndpoints = types.ListNull(types.StringType) },
+			want:   `{"local":{"anonymous_endpoints":null}}`,
+		},
+		{
+			name:   "added setting is sent",
+			modify: func(model *BridgeResourceModel) { model.Local.DialTimeoutSecs = types.Int64Value(30) },
+			want:   `{"local":{"dial_timeout_secs":30}}`,
+		},
+		{
+			name:   "removed job is reset",
+			modify: func(model *BridgeResourceModel) { model.Jobs.TunnelCreation = nil },
+			want:   `{"jobs":{"tunnel_creation":null}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			model := testBridgeModel()
+			tc.modify(&model)
+
+			req, diags := buildUpdateRequest(context.Background(), model, testBridgeModel())
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			got, err := json.Marshal(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+			if req.isEmpty() != (tc.want == `{}`) {
+				t.Errorf("isEmpty() = %t for %s", req.isEmpty(), got)
+			}
+		})
+	}
+}
+
+func TestBuildUpdateRequestWithoutPrior(t *testing.T) {
+	model := BridgeResourceModel{
+		MinTunnels: types.Int64Value(0),
+		TargetUsage: &bridgeTargetUsageModel{
+			Low:  types.Int64Value(1),
+			High: types.Int64Null(),
+		},
+	}
+
+	req, diags := buildUpdateRequest(context.Background(), model, BridgeResourceModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := `{"min_tunnels":0,"target_usage":{"low":1}}`
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestSetRemoteToken(t *testing.T) {
+	req := bridgeUpdateRequestModel{}
+	req.setRemoteToken("")
+	if !req.isEmpty() {
+		t.Fatalf("empty token must not be sent")
+	}
+
+	req.setRemoteToken("secret")
+	got, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := `{"remote":{"token":"secret"}}`
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestPatchObject(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    bridgeTargetUsagePatchModel
+		planSet  bool
+		priorSet bool
+		want     nullable[bridgeTargetUsagePatchModel]
+	}{
+		{
+			name:     "unchanged",
+			planSet:  true,
+			priorSet: true,
+			want:     nullable[bridgeTargetUsagePatchModel]{},
+		},
+		{
+			name:     "changed",
+			value:    bridgeTargetUsagePatchModel{Low: nullableValue[int64](1)},
+			planSet:  true,
+			priorSet: true,
+			want:     nullableValue(bridgeTargetUsagePatchModel{Low: nullableValue[int64](1)}),
+		},
+		{
+			name:     "added",
+			value:    bridgeTargetUsagePatchModel{High: nullableValue[int64](0)},
+			planSet:  true,
+			priorSet: false,
+			want:     nullableValue(bridgeTargetUsagePatchModel{High: nullableValue[int64](0)}),
+		},
+		{
+			name:     "removed",
+			planSet:  false,
+			priorSet: true,
+			want:     nullableNull[bridgeTargetUsagePatchModel](),
+		},
+		{
+			name:     "never set",
+			planSet:  false,
+			priorSet: false,
+			want:     nullable[bridgeTargetUsagePatchModel]{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := patchObject(tc.value, tc.planSet, tc.priorSet)
+			if got.IsZero() != tc.want.IsZero() {
+				t.Fatalf("IsZero() = %t, want %t", got.IsZero(), tc.want.IsZero())
+			}
+
+			gotJSON, _ := json.Marshal(got)
+			wantJSON, _ := json.Marshal(tc.want)
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("got %s, want %s", gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+func TestNullableMarshalJSON(t *testing.T) {
+	type payload struct {
+		Int    nullable[int64]    `json:"int,omitzero"`
+		Bool   nullable[bool]     `json:"bool,omitzero"`
+		String nullable[string]   `json:"string,omitzero"`
+		List   nullable[[]string] `json:"list,omitzero"`
+	}
+
+	testCases := []struct {
+		name  string
+		value payload
+		want  string
+	}{
+		{
+			name:  "omitted",
+			value: payload{},
+			want:  `{}`,
+		},
+		{
+			name: "null",
+			value: payload{
+				Int:    nullableNull[int64](),
+				Bool:   nullableNull[bool](),
+				String: nullableNull[string](),
+				List:   nullableNull[[]string](),
+			},
+			want: `{"int":null,"bool":null,"string":null,"list":null}`,
+		},
+		{
+			name: "zero values",
+			value: payload{
+				Int:    nullableValue[int64](0),
+				Bool:   nullableValue(false),
+				String: nullableValue(""),
+				List:   nullableValue([]string{}),
+			},
+			want: `{"int":0,"bool":false,"string":"","list":[]}`,
+		},
+		{
+			name: "values",
+			value: payload{
+				Int:    nullableValue[int64](42),
+				Bool:   nullableValue(true),
+				String: nullableValue("value"),
+				List:   nullableValue([]string{"a", "b"}),
+			},
+			want: `{"int":42,"bool":true,"string":"value","list":["a","b"]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(tc.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
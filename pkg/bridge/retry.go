@@ -0,0 +1,113 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultRetryMaxAttempts = 5
+	defaultRetryMaxWaitSecs = 30
+	retryMinWaitTime        = 1 * time.Second
+)
+
+// retryableMethods are the safe and idempotent methods, which can be sent again without side effects. POST and PATCH
+// are never retried: a create or re-pair that reached the bridge client before the failure must not be repeated.
+var retryableMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// retryableStatusCodes are returned by load balancers and the bridge client while it restarts or throttles requests.
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// configureRetry replaces the retry policy set by client.Build with a bounded exponential backoff with jitter, limited
+// to idempotent requests that failed with a transient error. maxAttempts includes the first request.
+func configureRetry(client *resty.Client, maxAttempts int64, maxWait time.Duration) *resty.Client {
+	client.RetryConditions = nil
+
+	return client.
+		SetRetryCount(int(max(maxAttempts-1, 0))).
+		SetRetryWaitTime(min(retryMinWaitTime, maxWait)).
+		SetRetryMaxWaitTime(maxWait).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(isRetryableRequest).
+		AddRetryHook(logRetry)
+}
+
+func isRetryableRequest(response *resty.Response, err error) bool {
+	if response == nil || response.Request == nil || !slices.Contains(retryableMethods, response.Request.Method) {
+		return false
+	}
+
+	// connection errors, e.g. reset by a load balancer during a rolling restart
+	if err != nil {
+		return true
+	}
+
+	return slices.Contains(retryableStatusCodes, response.StatusCode())
+}
+
+// retryAfter honors the Retry-After header (delay in seconds or HTTP date). Returning 0 falls back to the exponential
+// backoff; resty caps the result to the configured max wait.
+func retryAfter(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	value := response.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), nil
+	}
+
+	return 0, nil
+}
+
+func logRetry(response *resty.Response, err error) {
+	if response == nil || response.Request == nil {
+		return
+	}
+
+	fields := map[string]any{
+		"method":  response.Request.Method,
+		"url":     response.Request.URL,
+		"attempt": response.Request.Attempt,
+		"status":  response.StatusCode(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	tflog.Warn(response.Request.Context(), "Retrying bridge-client API request after transient failure", fields)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func testResponse(method string, statusCode int, header http.Header) *resty.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &resty.Response{
+		Request: &resty.Request{Method: method},
+		RawResponse: &http.Response{
+			StatusCode: statusCode,
+			Header:     header,
+		},
+	}
+}
+
+func TestIsRetryableRequest(t *testing.T) {
+	connectionErr := errors.New("connection reset by peer")
+
+	testCases := []struct {
+		name     string
+		response *resty.Response
+		err      error
+		want     bool
+	}{
+		{
+			name:     "no response",
+			response: nil,
+			err:      connectionErr,
+			want:     false,
+		},
+		{
+			name:     "no request",
+			response: &resty.Response{},
+			err:      connectionErr,
+			want:     false,
+		},
+		{
+			name:     "get success",
+			response: testResponse(http.MethodGet, http.StatusOK, nil),
+			want:     false,
+		},
+		{
+			name:     "get connection error",
+			response: testResponse(http.MethodGet, 0, nil),
+			err:      connectionErr,
+			want:     true,
+		},
+		{
+			name:     "get too many requests",
+			response: testResponse(http.MethodGet, http.StatusTooManyRequests, nil),
+			want:     true,
+		},
+		{
+			name:     "get bad gateway",
+			response: testResponse(http.MethodGet, http.StatusBadGateway, nil),
+			want:     true,
+		},
+		{
+			name:     "get service unavailable",
+			response: testResponse(http.MethodGet, http.StatusServiceUnavailable, nil),
+			want:     true,
+		},
+		{
+			name:     "get gateway timeout",
+			response: testResponse(http.MethodGet, http.StatusGatewayTimeout, nil),
+			want:     true,
+		},
+		{
+			name:     "get internal server error",
+			response: testResponse(http.MethodGet, http.StatusInternalServerError, nil),
+			want:     false,
+		},
+		{
+			name:     "get bad request",
+			response: testResponse(http.MethodGet, http.StatusBadRequest, nil),
+			want:     false,
+		},
+		{
+			name:     "delete service unavailable",
+			response: testResponse(http.MethodDelete, http.StatusServiceUnavailable, nil),
+			want:     true,
+		},
+		{
+			name:     "post service unavailable",
+			response: testResponse(http.MethodPost, http.StatusServiceUnavailable, nil),
+			want:     false,
+		},
+		{
+			name:     "post connection error",
+			response: testResponse(http.MethodPost, 0, nil),
+			err:      connectionErr,
+			want:     false,
+		},
+		{
+			name:     "patch service unavailable",
+			response: testResponse(http.MethodPatch, http.StatusServiceUnavailable, nil),
+			want:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isRetryableRequest(tc.response, tc.err); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name    string
+		value   string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "absent",
+			value:   "",
+			wantMin: 0,
+			wantMax: 0,
+		},
+		{
+			name:    "seconds",
+			value:   "5",
+			wantMin: 5 * time.Second,
+			wantMax: 5 * time.Second,
+		},
+		{
+			name:    "zero seconds",
+			value:   "0",
+			wantMin: 0,
+			wantMax: 0,
+		},
+		{
+			name:    "negative seconds",
+			value:   "-5",
+			wantMin: 0,
+			wantMax: 0,
+		},
+		{
+			name:    "future date",
+			value:   time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat),
+			wantMin: 8 * time.Second,
+			wantMax: 10 * time.Second,
+		},
+		{
+			name:    "past date",
+			value:   time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			wantMin: 0,
+			wantMax: 0,
+		},
+		{
+			name:    "invalid",
+			value:   "soon",
+			wantMin: 0,
+			wantMax: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			if tc.value != "" {
+				header.Set("Retry-After", tc.value)
+			}
+
+			got, err := retryAfter(nil, testResponse(http.MethodGet, http.StatusTooManyRequests, header))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got < tc.wantMin || got > tc.wantMax {
+				t.Errorf("got %s, want between %s and %s", got, tc.wantMin, tc.wantMax)
+			}
+		})
+	}
+}
+
+func TestConfigureRetry(t *testing.T) {
+	testCases := []struct {
+		maxAttempts int64
+		wantCount   int
+	}{
+		{maxAttempts: 0, wantCount: 0},
+		{maxAttempts: 1, wantCount: 0},
+		{maxAttempts: 5, wantCount: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strconv.FormatInt(tc.maxAttempts, 10), func(t *testing.T) {
+			client := configureRetry(resty.New(), tc.maxAttempts, 30*time.Second)
+			if client.RetryCount != tc.wantCount {
+				t.Errorf("got retry count %d, want %d", client.RetryCount, tc.wantCount)
+			}
+			if len(client.RetryConditions) != 1 {
+				t.Errorf("got %d retry conditions, want 1", len(client.RetryConditions))
+			}
+		})
+	}
+}
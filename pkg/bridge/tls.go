@@ -0,0 +1,80 @@
+// Copyright (c) JFrog Ltd. (2025)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridge
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// tlsSettings are the resolved TLS settings of the provider, from the provider configuration or environment variables.
+type tlsSettings struct {
+	Insecure      bool
+	CACertPEM     string
+	CACertFile    string
+	ClientCertPEM string
+	ClientKeyPEM  string
+}
+
+// isDefault reports whether the settings leave the TLS config of the client untouched.
+func (s tlsSettings) isDefault() bool {
+	return s == tlsSettings{}
+}
+
+// tlsConfig builds the TLS config of the platform client. The CA certificates are trusted in addition to the system
+// ones, so a private CA can be used without disabling verification. A client certificate enables mutual TLS.
+func (s tlsSettings) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: s.Insecure,
+	}
+
+	if s.CACertPEM != "" && s.CACertFile != "" {
+		return nil, fmt.Errorf("ca_cert_pem and ca_cert_file cannot be set together")
+	}
+
+	caCertPEM := []byte(s.CACertPEM)
+	if s.CACertFile != "" {
+		data, err := os.ReadFile(s.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+		}
+		caCertPEM = data
+	}
+	if len(caCertPEM) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCertPEM) {
+			return nil, fmt.Errorf("no PEM encoded certificate found in the CA certificate")
+		}
+		config.RootCAs = rootCAs
+	}
+
+	if s.ClientCertPEM != "" || s.ClientKeyPEM != "" {
+		if s.ClientCertPEM == "" || s.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client_cert_pem and client_key_pem must be set together")
+		}
+		clientCert, err := tls.X509KeyPair([]byte(s.ClientCertPEM), []byte(s.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		config.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return config, nil
+}